@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransitionRecord is one entry in a condition's transition history, kept
+// so a bounded number of past transitions can be inspected after the fact
+// instead of just the current condition. Written by conditions.Manager.
+type TransitionRecord struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// ObjectReadiness is the rolled-up readiness of a single owned object that
+// doesn't need more than a name and a boolean, e.g. a Deployment or a Service.
+type ObjectReadiness struct {
+	// Name is the name of the owned object.
+	Name string `json:"name"`
+
+	// Ready reports whether this object has reached its desired state.
+	Ready bool `json:"ready"`
+
+	// Reason is a short machine-readable explanation for why Ready is false.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// PodReadiness is the rolled-up readiness of a single owned Pod.
+type PodReadiness struct {
+	// Name is the name of the Pod.
+	Name string `json:"name"`
+
+	// Phase is the Pod's current phase.
+	Phase corev1.PodPhase `json:"phase"`
+
+	// Ready reports whether the Pod's Ready condition is true.
+	Ready bool `json:"ready"`
+}
+
+// ChildSummary is the rolled-up state of every child resource kind a
+// reconciler watches on behalf of a custom resource. It is embedded as
+// `Children` on a CR's status and filled in by internal/childstatus.
+type ChildSummary struct {
+	// +optional
+	Deployments []ObjectReadiness `json:"deployments,omitempty"`
+	// +optional
+	Services []ObjectReadiness `json:"services,omitempty"`
+	// +optional
+	Ingresses []ObjectReadiness `json:"ingresses,omitempty"`
+	// +optional
+	ConfigMaps []ObjectReadiness `json:"configMaps,omitempty"`
+	// +optional
+	DaemonSets []ObjectReadiness `json:"daemonSets,omitempty"`
+	// +optional
+	Jobs []ObjectReadiness `json:"jobs,omitempty"`
+	// +optional
+	Pods []PodReadiness `json:"pods,omitempty"`
+	// +optional
+	StatefulSets []ObjectReadiness `json:"statefulSets,omitempty"`
+}
+
+// Ready reports whether every tracked child is ready. A CR with no children
+// of a given kind is vacuously ready for that kind, so a CR that renders
+// none of its optional children (e.g. no Ingress) is not held back by them.
+func (s ChildSummary) Ready() bool {
+	for _, d := range s.Deployments {
+		if !d.Ready {
+			return false
+		}
+	}
+	for _, svc := range s.Services {
+		if !svc.Ready {
+			return false
+		}
+	}
+	for _, ing := range s.Ingresses {
+		if !ing.Ready {
+			return false
+		}
+	}
+	for _, cm := range s.ConfigMaps {
+		if !cm.Ready {
+			return false
+		}
+	}
+	for _, ds := range s.DaemonSets {
+		if !ds.Ready {
+			return false
+		}
+	}
+	for _, job := range s.Jobs {
+		if !job.Ready {
+			return false
+		}
+	}
+	for _, pod := range s.Pods {
+		if !pod.Ready {
+			return false
+		}
+	}
+	for _, sts := range s.StatefulSets {
+		if !sts.Ready {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuestbookSpec defines the desired state of Guestbook
+type GuestbookSpec struct {
+	// Replicas is the desired number of guestbook frontend replicas.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Image is the guestbook frontend container image to run.
+	Image string `json:"image"`
+
+	// Port is the container port the guestbook frontend listens on, and
+	// the port its Service exposes.
+	// +kubebuilder:default=80
+	Port int32 `json:"port,omitempty"`
+
+	// Host is the hostname to route to the guestbook Service. An Ingress
+	// is only rendered when Host is set.
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// GuestbookStatus defines the observed state of Guestbook
+type GuestbookStatus struct {
+	// Conditions represent the latest available observations of the
+	// Guestbook's state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the most recent generation observed by the
+	// controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Children is the rolled-up readiness of the Deployment, Service and
+	// other resources this Guestbook owns.
+	// +optional
+	Children ChildSummary `json:"children,omitempty"`
+
+	// ConditionHistory is a bounded log of recent condition transitions,
+	// kept for debugging. Written by conditions.Manager.
+	// +optional
+	ConditionHistory []TransitionRecord `json:"conditionHistory,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Available",type=string,JSONPath=`.status.conditions[?(@.type=="Available")].status`
+
+// Guestbook is the Schema for the guestbooks API
+type Guestbook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuestbookSpec   `json:"spec,omitempty"`
+	Status GuestbookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuestbookList contains a list of Guestbook
+type GuestbookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Guestbook `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Guestbook{}, &GuestbookList{})
+}
+
+// GetConditions implements conditions.Accessor.
+func (g *Guestbook) GetConditions() []metav1.Condition { return g.Status.Conditions }
+
+// SetConditions implements conditions.Accessor.
+func (g *Guestbook) SetConditions(c []metav1.Condition) { g.Status.Conditions = c }
+
+// GetConditionHistory implements conditions.Accessor.
+func (g *Guestbook) GetConditionHistory() []TransitionRecord { return g.Status.ConditionHistory }
+
+// SetConditionHistory implements conditions.Accessor.
+func (g *Guestbook) SetConditionHistory(h []TransitionRecord) { g.Status.ConditionHistory = h }
+
+// SetObservedGeneration records the generation a reconciler last acted on.
+func (g *Guestbook) SetObservedGeneration(generation int64) { g.Status.ObservedGeneration = generation }
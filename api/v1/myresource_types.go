@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MyResourceSpec defines the desired state of MyResource
+type MyResourceSpec struct {
+	// Replicas is the desired number of replicas for the workload this
+	// resource manages.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Image is the container image to run.
+	Image string `json:"image"`
+
+	// Port is the container port the workload listens on, and the port its
+	// Service exposes.
+	// +kubebuilder:default=8080
+	Port int32 `json:"port,omitempty"`
+}
+
+// MyResourceStatus defines the observed state of MyResource
+type MyResourceStatus struct {
+	// Conditions represent the latest available observations of the
+	// resource's state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the most recent generation observed by the
+	// controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Children is the rolled-up readiness of the resources this
+	// MyResource owns.
+	// +optional
+	Children ChildSummary `json:"children,omitempty"`
+
+	// Cleanup tracks the progress of each external cleanup step run while
+	// the resource is being deleted, keyed by CleanupStep.Name.
+	// +optional
+	Cleanup map[string]CleanupStepStatus `json:"cleanup,omitempty"`
+
+	// ConditionHistory is a bounded log of recent condition transitions,
+	// kept for debugging. Written by conditions.Manager.
+	// +optional
+	ConditionHistory []TransitionRecord `json:"conditionHistory,omitempty"`
+}
+
+// CleanupStepStatus is the observed progress of a single external cleanup
+// step run from MyResourceReconciler's reconcileDelete.
+type CleanupStepStatus struct {
+	// Phase is one of InProgress, Done or Failed.
+	Phase string `json:"phase"`
+
+	// LastError is the error returned by the step's most recent attempt,
+	// if it failed.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// Attempts counts how many times this step has been driven.
+	Attempts int32 `json:"attempts"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+
+// MyResource is the Schema for the myresources API
+type MyResource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MyResourceSpec   `json:"spec,omitempty"`
+	Status MyResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MyResourceList contains a list of MyResource
+type MyResourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MyResource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MyResource{}, &MyResourceList{})
+}
+
+// GetConditions implements conditions.Accessor.
+func (m *MyResource) GetConditions() []metav1.Condition { return m.Status.Conditions }
+
+// SetConditions implements conditions.Accessor.
+func (m *MyResource) SetConditions(c []metav1.Condition) { m.Status.Conditions = c }
+
+// GetConditionHistory implements conditions.Accessor.
+func (m *MyResource) GetConditionHistory() []TransitionRecord { return m.Status.ConditionHistory }
+
+// SetConditionHistory implements conditions.Accessor.
+func (m *MyResource) SetConditionHistory(h []TransitionRecord) { m.Status.ConditionHistory = h }
+
+// SetObservedGeneration records the generation a reconciler last acted on.
+func (m *MyResource) SetObservedGeneration(generation int64) {
+	m.Status.ObservedGeneration = generation
+}
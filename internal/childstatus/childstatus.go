@@ -0,0 +1,281 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package childstatus rolls the state of a custom resource's owned children
+// (Deployments, Services, Pods, ...) up into a webappv1.ChildSummary, and
+// wires the watches needed to re-reconcile the owner whenever one of those
+// children changes. Reconcilers embed a ChildTracker in SetupWithManager and
+// call StatusAggregator.Aggregate from Reconcile instead of hand-rolling a
+// single placeholder readiness check.
+package childstatus
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	webappv1 "github.com/jpfielding/kubeviewer/api/v1"
+)
+
+// trackedKinds is the full set of child kinds a ChildTracker watches.
+// Keeping this in one place means every reconciler that plugs in this
+// package watches the same set of kinds. StatusAggregator.Aggregate lists
+// the same kinds, each spelled out on its own rather than read from here.
+var trackedKinds = []client.Object{
+	&appsv1.Deployment{},
+	&corev1.Service{},
+	&networkingv1.Ingress{},
+	&corev1.ConfigMap{},
+	&appsv1.DaemonSet{},
+	&batchv1.Job{},
+	&corev1.Pod{},
+	&appsv1.StatefulSet{},
+}
+
+// ChildTracker registers the Owns() watches a reconciler needs so that
+// changes to any child it manages enqueue the owning CR.
+type ChildTracker struct{}
+
+// Apply adds an Owns() watch, filtered to events on objects with a
+// controller owner reference, for every tracked kind. Children whose
+// controller ref was removed (e.g. by a user adopting it by hand) no longer
+// have anything to tell the owner, so their events are filtered out here;
+// StatusAggregator.Aggregate separately drops them from the summary.
+func (ChildTracker) Apply(bldr *builder.Builder) *builder.Builder {
+	for _, kind := range trackedKinds {
+		bldr = bldr.Owns(kind, builder.WithPredicates(hasControllerRef()))
+	}
+	return bldr
+}
+
+func hasControllerRef() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return metav1.GetControllerOfNoCopy(obj) != nil
+	})
+}
+
+// StatusAggregator lists a CR's children by owner reference and rolls their
+// readiness up into a webappv1.ChildSummary.
+type StatusAggregator struct {
+	Client client.Client
+}
+
+// Aggregate lists every tracked kind in owner's namespace, keeps the ones
+// whose controller owner reference matches owner's UID, and returns their
+// rolled-up readiness. Children whose controller ref was lost are silently
+// dropped rather than reported as not-ready, since the owner no longer
+// controls them.
+func (a *StatusAggregator) Aggregate(ctx context.Context, owner client.Object) (webappv1.ChildSummary, error) {
+	var summary webappv1.ChildSummary
+	ns := owner.GetNamespace()
+	uid := owner.GetUID()
+
+	var deployments appsv1.DeploymentList
+	if err := a.Client.List(ctx, &deployments, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		if !ownedBy(d, uid) {
+			continue
+		}
+		summary.Deployments = append(summary.Deployments, deploymentReadiness(d))
+	}
+
+	var services corev1.ServiceList
+	if err := a.Client.List(ctx, &services, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range services.Items {
+		svc := &services.Items[i]
+		if !ownedBy(svc, uid) {
+			continue
+		}
+		summary.Services = append(summary.Services, serviceReadiness(svc))
+	}
+
+	var ingresses networkingv1.IngressList
+	if err := a.Client.List(ctx, &ingresses, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range ingresses.Items {
+		ing := &ingresses.Items[i]
+		if !ownedBy(ing, uid) {
+			continue
+		}
+		summary.Ingresses = append(summary.Ingresses, ingressReadiness(ing))
+	}
+
+	var configMaps corev1.ConfigMapList
+	if err := a.Client.List(ctx, &configMaps, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
+		if !ownedBy(cm, uid) {
+			continue
+		}
+		summary.ConfigMaps = append(summary.ConfigMaps, webappv1.ObjectReadiness{Name: cm.Name, Ready: true})
+	}
+
+	var daemonSets appsv1.DaemonSetList
+	if err := a.Client.List(ctx, &daemonSets, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range daemonSets.Items {
+		ds := &daemonSets.Items[i]
+		if !ownedBy(ds, uid) {
+			continue
+		}
+		summary.DaemonSets = append(summary.DaemonSets, daemonSetReadiness(ds))
+	}
+
+	var jobs batchv1.JobList
+	if err := a.Client.List(ctx, &jobs, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if !ownedBy(job, uid) {
+			continue
+		}
+		summary.Jobs = append(summary.Jobs, jobReadiness(job))
+	}
+
+	var pods corev1.PodList
+	if err := a.Client.List(ctx, &pods, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !ownedBy(pod, uid) {
+			continue
+		}
+		summary.Pods = append(summary.Pods, podReadiness(pod))
+	}
+
+	var statefulSets appsv1.StatefulSetList
+	if err := a.Client.List(ctx, &statefulSets, client.InNamespace(ns)); err != nil {
+		return summary, err
+	}
+	for i := range statefulSets.Items {
+		sts := &statefulSets.Items[i]
+		if !ownedBy(sts, uid) {
+			continue
+		}
+		summary.StatefulSets = append(summary.StatefulSets, statefulSetReadiness(sts))
+	}
+
+	return summary, nil
+}
+
+func ownedBy(obj client.Object, uid types.UID) bool {
+	ref := metav1.GetControllerOfNoCopy(obj)
+	return ref != nil && ref.UID == uid
+}
+
+func deploymentReadiness(d *appsv1.Deployment) webappv1.ObjectReadiness {
+	ready := d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas == *deploymentReplicas(d) &&
+		d.Status.ReadyReplicas == *deploymentReplicas(d)
+	reason := ""
+	if !ready {
+		reason = "DeploymentRolloutInProgress"
+	}
+	return webappv1.ObjectReadiness{Name: d.Name, Ready: ready, Reason: reason}
+}
+
+func deploymentReplicas(d *appsv1.Deployment) *int32 {
+	if d.Spec.Replicas != nil {
+		return d.Spec.Replicas
+	}
+	one := int32(1)
+	return &one
+}
+
+func serviceReadiness(svc *corev1.Service) webappv1.ObjectReadiness {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return webappv1.ObjectReadiness{Name: svc.Name, Ready: true}
+	}
+	ready := len(svc.Status.LoadBalancer.Ingress) > 0
+	reason := ""
+	if !ready {
+		reason = "LoadBalancerPending"
+	}
+	return webappv1.ObjectReadiness{Name: svc.Name, Ready: ready, Reason: reason}
+}
+
+func ingressReadiness(ing *networkingv1.Ingress) webappv1.ObjectReadiness {
+	ready := len(ing.Status.LoadBalancer.Ingress) > 0
+	reason := ""
+	if !ready {
+		reason = "LoadBalancerPending"
+	}
+	return webappv1.ObjectReadiness{Name: ing.Name, Ready: ready, Reason: reason}
+}
+
+func daemonSetReadiness(ds *appsv1.DaemonSet) webappv1.ObjectReadiness {
+	ready := ds.Status.DesiredNumberScheduled == ds.Status.NumberReady
+	reason := ""
+	if !ready {
+		reason = "DaemonSetRolloutInProgress"
+	}
+	return webappv1.ObjectReadiness{Name: ds.Name, Ready: ready, Reason: reason}
+}
+
+func jobReadiness(job *batchv1.Job) webappv1.ObjectReadiness {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobComplete && c.Status == corev1.ConditionTrue {
+			return webappv1.ObjectReadiness{Name: job.Name, Ready: true}
+		}
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return webappv1.ObjectReadiness{Name: job.Name, Ready: false, Reason: "JobFailed"}
+		}
+	}
+	return webappv1.ObjectReadiness{Name: job.Name, Ready: false, Reason: "JobRunning"}
+}
+
+func statefulSetReadiness(sts *appsv1.StatefulSet) webappv1.ObjectReadiness {
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	ready := sts.Status.ReadyReplicas == replicas
+	reason := ""
+	if !ready {
+		reason = "StatefulSetRolloutInProgress"
+	}
+	return webappv1.ObjectReadiness{Name: sts.Name, Ready: ready, Reason: reason}
+}
+
+func podReadiness(pod *corev1.Pod) webappv1.PodReadiness {
+	ready := false
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			ready = true
+			break
+		}
+	}
+	return webappv1.PodReadiness{Name: pod.Name, Phase: pod.Status.Phase, Ready: ready}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package childstatus_test
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	webappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/internal/childstatus"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding appsv1 to scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding corev1 to scheme: %v", err)
+	}
+	if err := networkingv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding networkingv1 to scheme: %v", err)
+	}
+	if err := batchv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding batchv1 to scheme: %v", err)
+	}
+	if err := webappv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding webapp v1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestAggregate_IgnoresOrphanedChildrenAndRollsUpReadiness(t *testing.T) {
+	owner := &webappv1.Guestbook{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: types.UID("owner-uid")},
+	}
+	ownerRef := metav1.NewControllerRef(owner, webappv1.GroupVersion.WithKind("Guestbook"))
+
+	replicas := int32(2)
+	owned := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "owned", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 2, ReadyReplicas: 2},
+	}
+	orphan := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owned, orphan).Build()
+
+	aggregator := &childstatus.StatusAggregator{Client: c}
+	summary, err := aggregator.Aggregate(context.Background(), owner)
+	if err != nil {
+		t.Fatalf("Aggregate returned error: %v", err)
+	}
+
+	if len(summary.Deployments) != 1 {
+		t.Fatalf("Deployments = %v, want exactly the owned Deployment (orphan must be ignored)", summary.Deployments)
+	}
+	if got := summary.Deployments[0]; got.Name != "owned" || !got.Ready {
+		t.Fatalf("Deployments[0] = %+v, want {Name: owned, Ready: true}", got)
+	}
+	if !summary.Ready() {
+		t.Fatalf("summary.Ready() = false, want true when the only owned child is ready")
+	}
+}
+
+func TestAggregate_NotReadyWhenRolloutInProgress(t *testing.T) {
+	owner := &webappv1.Guestbook{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: types.UID("owner-uid")},
+	}
+	ownerRef := metav1.NewControllerRef(owner, webappv1.GroupVersion.WithKind("Guestbook"))
+
+	replicas := int32(2)
+	owned := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "owned", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+	}
+
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owned).Build()
+
+	aggregator := &childstatus.StatusAggregator{Client: c}
+	summary, err := aggregator.Aggregate(context.Background(), owner)
+	if err != nil {
+		t.Fatalf("Aggregate returned error: %v", err)
+	}
+	if summary.Ready() {
+		t.Fatalf("summary.Ready() = true, want false while the Deployment is still rolling out")
+	}
+}
@@ -19,25 +19,33 @@ package controller
 import (
 	"context"
 
-	"k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	webappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/internal/childstatus"
+	"github.com/jpfielding/kubeviewer/pkg/conditions"
 )
 
 // GuestbookReconciler reconciles a Guestbook object
 type GuestbookReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Conditions marks the Available condition and coalesces the status
+	// write this Reconcile performs.
+	Conditions *conditions.Manager
 }
 
 // +kubebuilder:rbac:groups=webapp.github.com,resources=guestbooks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=webapp.github.com,resources=guestbooks/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=webapp.github.com,resources=guestbooks/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -50,16 +58,29 @@ func (r *GuestbookReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err := r.Get(ctx, req.NamespacedName, guestbook); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	original := guestbook.DeepCopy()
+
+	if err := r.reconcileChildren(ctx, guestbook); err != nil {
+		log.Error(err, "unable to reconcile Guestbook children")
+		return ctrl.Result{}, err
+	}
+
+	aggregator := &childstatus.StatusAggregator{Client: r.Client}
+	children, err := aggregator.Aggregate(ctx, guestbook)
+	if err != nil {
+		log.Error(err, "unable to aggregate Guestbook child status")
+		return ctrl.Result{}, err
+	}
+	guestbook.Status.Children = children
+
+	if children.Ready() {
+		r.Conditions.MarkTrue(guestbook, "Available", "Reconciled", "Guestbook spec reconciled")
+	} else {
+		r.Conditions.MarkFalse(guestbook, "Available", "ChildrenNotReady", "Waiting for owned resources to become ready")
+	}
+	guestbook.Status.ObservedGeneration = guestbook.GetGeneration()
 
-	available := meta.FindStatusCondition(guestbook.Status.Conditions, "Available")
-	if available == nil || available.ObservedGeneration != guestbook.GetGeneration() || available.Status != metav1.ConditionTrue {
-		meta.SetStatusCondition(&guestbook.Status.Conditions, metav1.Condition{
-			Type:               "Available",
-			Status:             metav1.ConditionTrue,
-			Reason:             "Reconciled",
-			Message:            "Guestbook spec reconciled",
-			ObservedGeneration: guestbook.GetGeneration(),
-		})
+	if !equality.Semantic.DeepEqual(original.Status, guestbook.Status) {
 		if err := r.Status().Update(ctx, guestbook); err != nil {
 			log.Error(err, "unable to update Guestbook status")
 			return ctrl.Result{}, err
@@ -71,8 +92,15 @@ func (r *GuestbookReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GuestbookReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&webappv1.Guestbook{}).
-		Named("guestbook").
-		Complete(r)
+	r.Conditions = conditions.NewManager(conditions.Config{
+		Kind:       "Guestbook",
+		Types:      []string{"Available", "Progressing"},
+		Polarities: []conditions.Polarity{{A: "Available", B: "Progressing"}},
+		Recorder:   mgr.GetEventRecorderFor("guestbook-controller"),
+	})
+	return childstatus.ChildTracker{}.Apply(
+		ctrl.NewControllerManagedBy(mgr).
+			For(&webappv1.Guestbook{}).
+			Named("guestbook"),
+	).Complete(r)
 }
@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	webappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/pkg/apply"
+)
+
+// deploymentOwnership, serviceOwnership and ingressOwnership declare the
+// only fields GuestbookReconciler manages on each child it renders. Apply
+// never writes outside these paths, so it won't fight a webhook, an HPA, or
+// a user hand-editing anything else on the object.
+var (
+	deploymentOwnership = apply.Ownership{
+		"spec.replicas",
+		"spec.selector",
+		"spec.template.metadata.labels",
+		"spec.template.spec.containers[*].image",
+		"spec.template.spec.containers[*].ports",
+	}
+	serviceOwnership = apply.Ownership{
+		"spec.selector",
+		"spec.ports",
+	}
+	ingressOwnership = apply.Ownership{
+		"spec.rules",
+	}
+)
+
+func guestbookLabels(guestbook *webappv1.Guestbook) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "guestbook",
+		"app.kubernetes.io/instance":   guestbook.Name,
+		"app.kubernetes.io/managed-by": "guestbook-controller",
+	}
+}
+
+// reconcileChildren renders the Deployment, Service and (if Spec.Host is
+// set) Ingress a Guestbook describes and applies each with apply.Apply, so
+// drift introduced by webhooks or other actors on fields we don't own is
+// left alone.
+func (r *GuestbookReconciler) reconcileChildren(ctx context.Context, guestbook *webappv1.Guestbook) error {
+	deployment, err := renderDeployment(guestbook, r.Scheme)
+	if err != nil {
+		return fmt.Errorf("rendering Deployment: %w", err)
+	}
+	if err := apply.Apply(ctx, r.Client, r.Scheme, deployment, deploymentOwnership); err != nil {
+		return fmt.Errorf("applying Deployment: %w", err)
+	}
+
+	service, err := renderService(guestbook, r.Scheme)
+	if err != nil {
+		return fmt.Errorf("rendering Service: %w", err)
+	}
+	if err := apply.Apply(ctx, r.Client, r.Scheme, service, serviceOwnership); err != nil {
+		return fmt.Errorf("applying Service: %w", err)
+	}
+
+	if guestbook.Spec.Host == "" {
+		return nil
+	}
+	ingress, err := renderIngress(guestbook, r.Scheme)
+	if err != nil {
+		return fmt.Errorf("rendering Ingress: %w", err)
+	}
+	if err := apply.Apply(ctx, r.Client, r.Scheme, ingress, ingressOwnership); err != nil {
+		return fmt.Errorf("applying Ingress: %w", err)
+	}
+	return nil
+}
+
+func renderDeployment(guestbook *webappv1.Guestbook, scheme *runtime.Scheme) (*appsv1.Deployment, error) {
+	labels := guestbookLabels(guestbook)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      guestbook.Name,
+			Namespace: guestbook.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: guestbook.Spec.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "guestbook",
+						Image: guestbook.Spec.Image,
+						Ports: []corev1.ContainerPort{{ContainerPort: guestbook.Spec.Port}},
+					}},
+				},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(guestbook, deployment, scheme); err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func renderService(guestbook *webappv1.Guestbook, scheme *runtime.Scheme) (*corev1.Service, error) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      guestbook.Name,
+			Namespace: guestbook.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: guestbookLabels(guestbook),
+			Ports: []corev1.ServicePort{{
+				Port:       guestbook.Spec.Port,
+				TargetPort: intstr.FromInt32(guestbook.Spec.Port),
+			}},
+		},
+	}
+	if err := controllerutil.SetControllerReference(guestbook, service, scheme); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+func renderIngress(guestbook *webappv1.Guestbook, scheme *runtime.Scheme) (*networkingv1.Ingress, error) {
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      guestbook.Name,
+			Namespace: guestbook.Namespace,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: guestbook.Spec.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: guestbook.Name,
+									Port: networkingv1.ServiceBackendPort{Number: guestbook.Spec.Port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if err := controllerutil.SetControllerReference(guestbook, ingress, scheme); err != nil {
+		return nil, err
+	}
+	return ingress, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+)
+
+// CleanupStep is one external cleanup action that must complete before
+// MyResourceReconciler removes its finalizer. Do is retried on every
+// reconcileDelete call until IsDone reports true.
+type CleanupStep struct {
+	// Name identifies the step in status.cleanup and in events; it must
+	// be unique within a MyResourceReconciler's CleanupSteps.
+	Name string
+
+	// Do attempts the cleanup action. It's called only when IsDone has
+	// most recently reported false, and may be called multiple times if
+	// it errors or the resource isn't done cleaning up yet.
+	Do func(ctx context.Context, resource *myappv1.MyResource) error
+
+	// IsDone reports whether this step's cleanup has already completed,
+	// so reconcileDelete can skip calling Do again.
+	IsDone func(ctx context.Context, resource *myappv1.MyResource) (bool, error)
+}
+
+const (
+	cleanupMinBackoff    = 10 * time.Second
+	cleanupMaxBackoff    = 5 * time.Minute
+	cleanupMaxBackoffExp = 5 // 10s * 2^5 = 320s, already past cleanupMaxBackoff
+)
+
+// cleanupBackoff returns the RequeueAfter for a step that has been attempted
+// attempts times, doubling from cleanupMinBackoff up to cleanupMaxBackoff so
+// a slow or unreachable external system is polled gently rather than at
+// controller-runtime's default rate-limiter cadence.
+func cleanupBackoff(attempts int32) time.Duration {
+	if attempts > cleanupMaxBackoffExp {
+		attempts = cleanupMaxBackoffExp
+	}
+	backoff := cleanupMinBackoff << attempts
+	if backoff > cleanupMaxBackoff {
+		return cleanupMaxBackoff
+	}
+	return backoff
+}
+
+// reconcileDelete drives every registered CleanupStep to completion,
+// recording per-step progress into status.cleanup, before removing the
+// finalizer. A step that errors or isn't done yet blocks finalizer removal
+// but doesn't stop the other steps from being attempted.
+func (r *MyResourceReconciler) reconcileDelete(ctx context.Context, resource *myappv1.MyResource) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(resource, myResourceFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	original := resource.DeepCopy()
+	if resource.Status.Cleanup == nil {
+		resource.Status.Cleanup = map[string]myappv1.CleanupStepStatus{}
+	}
+
+	allDone := true
+	var blockedBy string
+	var requeueAfter time.Duration
+
+	for _, step := range r.CleanupSteps {
+		stepStatus := resource.Status.Cleanup[step.Name]
+
+		done, err := step.IsDone(ctx, resource)
+		if err == nil && !done {
+			err = step.Do(ctx, resource)
+		}
+
+		switch {
+		case err != nil:
+			stepStatus.Phase = "Failed"
+			stepStatus.LastError = err.Error()
+			stepStatus.Attempts++
+			log.Error(err, "cleanup step failed", "step", step.Name)
+			if r.Recorder != nil {
+				r.Recorder.Eventf(resource, corev1.EventTypeWarning, "CleanupFailed", "cleanup step %q failed: %v", step.Name, err)
+			}
+		case !done:
+			stepStatus.Phase = "InProgress"
+			stepStatus.Attempts++
+		default:
+			stepStatus.Phase = "Done"
+			stepStatus.LastError = ""
+		}
+		resource.Status.Cleanup[step.Name] = stepStatus
+
+		if stepStatus.Phase != "Done" {
+			allDone = false
+			if blockedBy == "" {
+				blockedBy = step.Name
+			}
+			if backoff := cleanupBackoff(stepStatus.Attempts); requeueAfter == 0 || backoff < requeueAfter {
+				requeueAfter = backoff
+			}
+		}
+	}
+
+	if allDone {
+		r.Conditions.MarkFalse(resource, "Terminating", "CleanupComplete", "all cleanup steps complete")
+	} else {
+		r.Conditions.MarkTrue(resource, "Terminating", "CleanupInProgress", fmt.Sprintf("waiting on cleanup step %q", blockedBy))
+	}
+	resource.Status.ObservedGeneration = resource.GetGeneration()
+
+	if !equality.Semantic.DeepEqual(original.Status, resource.Status) {
+		if err := r.Status().Update(ctx, resource); err != nil {
+			log.Error(err, "unable to update MyResource cleanup status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !allDone {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	controllerutil.RemoveFinalizer(resource, myResourceFinalizer)
+	if err := r.Update(ctx, resource); err != nil {
+		log.Error(err, "unable to remove finalizer from MyResource")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
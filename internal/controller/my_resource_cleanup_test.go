@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/pkg/conditions"
+)
+
+func newCleanupTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := myappv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding api/v1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+func newTerminatingResource(name string) *myappv1.MyResource {
+	resource := &myappv1.MyResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			Finalizers:        []string{myResourceFinalizer},
+		},
+	}
+	return resource
+}
+
+func newCleanupReconciler(scheme *runtime.Scheme, resource *myappv1.MyResource, steps ...CleanupStep) *MyResourceReconciler {
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resource).WithStatusSubresource(resource).Build()
+	return &MyResourceReconciler{
+		Client:       c,
+		Recorder:     record.NewFakeRecorder(10),
+		CleanupSteps: steps,
+		Conditions: conditions.NewManager(conditions.Config{
+			Kind:  "MyResource",
+			Types: []string{"Ready", "Terminating"},
+		}),
+	}
+}
+
+// failThenSucceedStep fails its first attempt, then reports done on the
+// attempt after Do next runs without error.
+func failThenSucceedStep() (CleanupStep, *int) {
+	calls := 0
+	step := CleanupStep{
+		Name: "external-cleanup",
+		IsDone: func(context.Context, *myappv1.MyResource) (bool, error) {
+			return calls >= 2, nil
+		},
+		Do: func(context.Context, *myappv1.MyResource) error {
+			calls++
+			if calls == 1 {
+				return errors.New("transient failure")
+			}
+			return nil
+		},
+	}
+	return step, &calls
+}
+
+func TestReconcileDelete_StepRecoversFromFailure(t *testing.T) {
+	scheme := newCleanupTestScheme(t)
+	resource := newTerminatingResource("demo")
+	step, _ := failThenSucceedStep()
+	r := newCleanupReconciler(scheme, resource, step)
+	ctx := context.Background()
+
+	// Attempt 1: IsDone reports false, Do fails.
+	if _, err := r.reconcileDelete(ctx, resource); err != nil {
+		t.Fatalf("reconcileDelete (attempt 1): %v", err)
+	}
+	got := resource.Status.Cleanup["external-cleanup"]
+	if got.Phase != "Failed" || got.Attempts != 1 || got.LastError == "" {
+		t.Fatalf("status after attempt 1 = %+v, want Phase Failed, Attempts 1, LastError set", got)
+	}
+
+	// Attempt 2: IsDone still reports false, Do now succeeds but hasn't
+	// been confirmed done yet.
+	if _, err := r.reconcileDelete(ctx, resource); err != nil {
+		t.Fatalf("reconcileDelete (attempt 2): %v", err)
+	}
+	got = resource.Status.Cleanup["external-cleanup"]
+	if got.Phase != "InProgress" || got.Attempts != 2 {
+		t.Fatalf("status after attempt 2 = %+v, want Phase InProgress, Attempts 2", got)
+	}
+	if !controllerutil.ContainsFinalizer(resource, myResourceFinalizer) {
+		t.Fatalf("finalizer removed before cleanup finished")
+	}
+
+	// Attempt 3: IsDone now reports true, so Do isn't called again and
+	// LastError clears.
+	if _, err := r.reconcileDelete(ctx, resource); err != nil {
+		t.Fatalf("reconcileDelete (attempt 3): %v", err)
+	}
+	got = resource.Status.Cleanup["external-cleanup"]
+	if got.Phase != "Done" || got.LastError != "" {
+		t.Fatalf("status after attempt 3 = %+v, want Phase Done, LastError cleared", got)
+	}
+	if controllerutil.ContainsFinalizer(resource, myResourceFinalizer) {
+		t.Fatalf("finalizer still present once every step is done")
+	}
+}
+
+func TestReconcileDelete_BlocksOnFirstUnfinishedStep(t *testing.T) {
+	scheme := newCleanupTestScheme(t)
+	resource := newTerminatingResource("demo")
+
+	doneStep := CleanupStep{
+		Name:   "already-done",
+		IsDone: func(context.Context, *myappv1.MyResource) (bool, error) { return true, nil },
+		Do: func(context.Context, *myappv1.MyResource) error {
+			t.Fatalf("Do called for a step IsDone already reported done")
+			return nil
+		},
+	}
+	blockingStep := CleanupStep{
+		Name:   "still-running",
+		IsDone: func(context.Context, *myappv1.MyResource) (bool, error) { return false, nil },
+		Do:     func(context.Context, *myappv1.MyResource) error { return nil },
+	}
+
+	r := newCleanupReconciler(scheme, resource, doneStep, blockingStep)
+
+	result, err := r.reconcileDelete(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("reconcileDelete: %v", err)
+	}
+	if want := cleanupBackoff(1); result.RequeueAfter != want {
+		t.Fatalf("RequeueAfter = %v, want %v (still-running step's first-attempt backoff)", result.RequeueAfter, want)
+	}
+	if !controllerutil.ContainsFinalizer(resource, myResourceFinalizer) {
+		t.Fatalf("finalizer removed while a cleanup step is still running")
+	}
+	if resource.Status.Cleanup["already-done"].Phase != "Done" {
+		t.Fatalf("already-done step's Phase = %q, want Done", resource.Status.Cleanup["already-done"].Phase)
+	}
+	if resource.Status.Cleanup["still-running"].Phase != "InProgress" {
+		t.Fatalf("still-running step's Phase = %q, want InProgress", resource.Status.Cleanup["still-running"].Phase)
+	}
+}
+
+func TestCleanupBackoff_CapsAtMax(t *testing.T) {
+	cases := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: cleanupMinBackoff},
+		{attempts: 3, want: 80 * time.Second},
+		{attempts: cleanupMaxBackoffExp, want: cleanupMaxBackoff},
+		{attempts: cleanupMaxBackoffExp + 10, want: cleanupMaxBackoff},
+	}
+	for _, tc := range cases {
+		if got := cleanupBackoff(tc.attempts); got != tc.want {
+			t.Errorf("cleanupBackoff(%d) = %v, want %v", tc.attempts, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/internal/childstatus"
+	"github.com/jpfielding/kubeviewer/pkg/conditions"
+	"github.com/jpfielding/kubeviewer/pkg/subreconciler"
+)
+
+// myResourceFinalizer is set on every MyResource so deletion can be
+// intercepted to clean up resources we manage outside the cluster.
+const myResourceFinalizer = "webapp.github.com/my-resource-finalizer"
+
+// MyResourceReconciler reconciles a MyResource object
+type MyResourceReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// CleanupSteps are run in order by reconcileDelete before the
+	// finalizer is removed. Unset by default; callers register the
+	// external cleanup their environment needs (database entries, cloud
+	// resources, child CRs) in main.go.
+	CleanupSteps []CleanupStep
+
+	// Conditions marks the Ready condition from the AggregateStatus step
+	// and coalesces the status write the pipeline performs.
+	Conditions *conditions.Manager
+}
+
+// +kubebuilder:rbac:groups=webapp.github.com,resources=myresources,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=webapp.github.com,resources=myresources/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=webapp.github.com,resources=myresources/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile loads the MyResource and, unless it's being deleted, drives it
+// through a pipeline of SubReconciler steps. See pkg/subreconciler for how
+// the pipeline is run.
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.23.1/pkg/reconcile
+func (r *MyResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	resource := &myappv1.MyResource{}
+	if err := r.Get(ctx, req.NamespacedName, resource); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !resource.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, resource)
+	}
+
+	pipeline := &subreconciler.Pipeline[*myappv1.MyResource]{
+		Client: r.Client,
+		Steps: []subreconciler.SubReconciler[*myappv1.MyResource]{
+			&EnsureFinalizer{Client: r.Client},
+			&ReconcileDeployment{Client: r.Client, Scheme: r.Scheme},
+			&ReconcileService{Client: r.Client, Scheme: r.Scheme},
+			&AggregateStatus{Client: r.Client, Conditions: r.Conditions},
+		},
+	}
+	result, err := pipeline.Reconcile(ctx, resource)
+	if err != nil {
+		log.Error(err, "MyResource reconciler pipeline failed")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Requeue: result.Halt, RequeueAfter: result.RequeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MyResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("myresource-controller")
+	r.Conditions = conditions.NewManager(conditions.Config{
+		Kind:       "MyResource",
+		Types:      []string{"Ready", "Degraded", "Terminating"},
+		Polarities: []conditions.Polarity{{A: "Ready", B: "Degraded"}},
+		Recorder:   r.Recorder,
+	})
+
+	return childstatus.ChildTracker{}.Apply(
+		ctrl.NewControllerManagedBy(mgr).
+			For(&myappv1.MyResource{}).
+			Named("myresource"),
+	).Complete(r)
+}
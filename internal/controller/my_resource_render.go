@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/pkg/apply"
+	"github.com/jpfielding/kubeviewer/pkg/subreconciler"
+)
+
+// myResourceDeploymentOwnership and myResourceServiceOwnership declare the
+// only fields these steps manage on each child they render, mirroring
+// guestbook_render.go's ownership lists.
+var (
+	myResourceDeploymentOwnership = apply.Ownership{
+		"spec.replicas",
+		"spec.selector",
+		"spec.template.metadata.labels",
+		"spec.template.spec.containers[*].image",
+		"spec.template.spec.containers[*].ports",
+	}
+	myResourceServiceOwnership = apply.Ownership{
+		"spec.selector",
+		"spec.ports",
+	}
+)
+
+func myResourceLabels(resource *myappv1.MyResource) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "myresource",
+		"app.kubernetes.io/instance":   resource.Name,
+		"app.kubernetes.io/managed-by": "myresource-controller",
+	}
+}
+
+// ReconcileDeployment renders and applies the Deployment that runs
+// resource.Spec.Image, leaving any field it doesn't own (defaults, HPA
+// replica counts, webhook mutations) untouched.
+type ReconcileDeployment struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+func (s *ReconcileDeployment) Name() string { return "ReconcileDeployment" }
+
+func (s *ReconcileDeployment) Reconcile(ctx context.Context, resource *myappv1.MyResource) (subreconciler.Result, error) {
+	deployment, err := renderMyResourceDeployment(resource, s.Scheme)
+	if err != nil {
+		return subreconciler.Result{}, fmt.Errorf("rendering Deployment: %w", err)
+	}
+	if err := apply.Apply(ctx, s.Client, s.Scheme, deployment, myResourceDeploymentOwnership); err != nil {
+		return subreconciler.Result{}, fmt.Errorf("applying Deployment: %w", err)
+	}
+	return subreconciler.Result{}, nil
+}
+
+// ReconcileService renders and applies the Service that exposes
+// resource.Spec.Port on the Deployment ReconcileDeployment manages.
+type ReconcileService struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+func (s *ReconcileService) Name() string { return "ReconcileService" }
+
+func (s *ReconcileService) Reconcile(ctx context.Context, resource *myappv1.MyResource) (subreconciler.Result, error) {
+	service, err := renderMyResourceService(resource, s.Scheme)
+	if err != nil {
+		return subreconciler.Result{}, fmt.Errorf("rendering Service: %w", err)
+	}
+	if err := apply.Apply(ctx, s.Client, s.Scheme, service, myResourceServiceOwnership); err != nil {
+		return subreconciler.Result{}, fmt.Errorf("applying Service: %w", err)
+	}
+	return subreconciler.Result{}, nil
+}
+
+func renderMyResourceDeployment(resource *myappv1.MyResource, scheme *runtime.Scheme) (*appsv1.Deployment, error) {
+	labels := myResourceLabels(resource)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resource.Name,
+			Namespace: resource.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: resource.Spec.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "myresource",
+						Image: resource.Spec.Image,
+						Ports: []corev1.ContainerPort{{ContainerPort: resource.Spec.Port}},
+					}},
+				},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(resource, deployment, scheme); err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func renderMyResourceService(resource *myappv1.MyResource, scheme *runtime.Scheme) (*corev1.Service, error) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resource.Name,
+			Namespace: resource.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: myResourceLabels(resource),
+			Ports: []corev1.ServicePort{{
+				Port:       resource.Spec.Port,
+				TargetPort: intstr.FromInt32(resource.Spec.Port),
+			}},
+		},
+	}
+	if err := controllerutil.SetControllerReference(resource, service, scheme); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/internal/childstatus"
+	"github.com/jpfielding/kubeviewer/pkg/conditions"
+	"github.com/jpfielding/kubeviewer/pkg/subreconciler"
+)
+
+// EnsureFinalizer adds myResourceFinalizer if it's missing. It writes
+// immediately and halts the pipeline, since the remaining steps would
+// otherwise act on metadata that's about to be stale.
+type EnsureFinalizer struct {
+	Client client.Client
+}
+
+func (s *EnsureFinalizer) Name() string { return "EnsureFinalizer" }
+
+func (s *EnsureFinalizer) Reconcile(ctx context.Context, resource *myappv1.MyResource) (subreconciler.Result, error) {
+	if controllerutil.ContainsFinalizer(resource, myResourceFinalizer) {
+		return subreconciler.Result{}, nil
+	}
+	controllerutil.AddFinalizer(resource, myResourceFinalizer)
+	if err := s.Client.Update(ctx, resource); err != nil {
+		return subreconciler.Result{}, err
+	}
+	return subreconciler.Result{Halt: true}, nil
+}
+
+// AggregateStatus rolls up the resource's owned children via childstatus and
+// marks the Ready condition from the result. Conditions records the
+// transition event and history itself, so this step doesn't need an
+// EmitEvents step behind it.
+type AggregateStatus struct {
+	Client     client.Client
+	Conditions *conditions.Manager
+}
+
+func (s *AggregateStatus) Name() string { return "AggregateStatus" }
+
+func (s *AggregateStatus) Reconcile(ctx context.Context, resource *myappv1.MyResource) (subreconciler.Result, error) {
+	aggregator := &childstatus.StatusAggregator{Client: s.Client}
+	children, err := aggregator.Aggregate(ctx, resource)
+	if err != nil {
+		return subreconciler.Result{}, err
+	}
+	resource.Status.Children = children
+
+	if children.Ready() {
+		s.Conditions.MarkTrue(resource, "Ready", "Reconciled", "MyResource spec reconciled")
+	} else {
+		s.Conditions.MarkFalse(resource, "Ready", "ChildrenNotReady", "Waiting for owned resources to become ready")
+	}
+
+	return subreconciler.Result{}, nil
+}
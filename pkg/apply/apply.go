@@ -0,0 +1,284 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply three-way merges a desired object into the cluster,
+// touching only the fields a controller declares ownership of. It's the
+// same technique `kubectl apply` uses (a last-applied-configuration
+// annotation records what was written last time, so a later external edit
+// can be told apart from a stale value of our own), scoped down to a
+// declared set of field paths instead of the whole object, so the merge
+// never fights a webhook, an HPA, or another controller writing to the
+// same object.
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// LastAppliedAnnotation records the JSON snapshot of the owned fields this
+// package wrote last time, keyed by the object it's set on.
+const LastAppliedAnnotation = "webapp.github.com/last-applied-configuration"
+
+// Ownership is the set of JSON field paths a controller manages on an
+// applied object, e.g. "spec.replicas" or
+// "spec.template.spec.containers[*].image". A `[*]` path segment applies
+// the rest of the path to every element of the list at that point.
+type Ownership []string
+
+// Apply three-way merges desired into the live object sharing its
+// name/namespace, creating it if it doesn't exist. Only the fields named by
+// owns are ever written back; fields the live object has that aren't in
+// owns -- defaults the apiserver filled in, a webhook's mutations, an HPA's
+// replica count -- are left exactly as they are.
+func Apply(ctx context.Context, c client.Client, scheme *runtime.Scheme, desired client.Object, owns Ownership) error {
+	desiredU, err := toUnstructured(scheme, desired)
+	if err != nil {
+		return fmt.Errorf("apply: converting desired object: %w", err)
+	}
+
+	live := desired.DeepCopyObject().(client.Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("apply: getting live object: %w", err)
+		}
+		if err := stampLastApplied(desiredU, desiredU, owns); err != nil {
+			return err
+		}
+		if err := fromUnstructured(scheme, desiredU, desired); err != nil {
+			return fmt.Errorf("apply: converting created object: %w", err)
+		}
+		return c.Create(ctx, desired)
+	}
+
+	liveU, err := toUnstructured(scheme, live)
+	if err != nil {
+		return fmt.Errorf("apply: converting live object: %w", err)
+	}
+
+	lastApplied := map[string]any{}
+	if raw := live.GetAnnotations()[LastAppliedAnnotation]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &lastApplied); err != nil {
+			return fmt.Errorf("apply: parsing %s: %w", LastAppliedAnnotation, err)
+		}
+	}
+
+	merged := liveU.DeepCopy()
+	for _, path := range owns {
+		mergeField(merged.Object, liveU.Object, lastApplied, desiredU.Object, strings.Split(path, "."))
+	}
+
+	if reflect.DeepEqual(liveU.Object, merged.Object) {
+		return nil
+	}
+
+	if err := stampLastApplied(merged, desiredU, owns); err != nil {
+		return err
+	}
+	if err := fromUnstructured(scheme, merged, live); err != nil {
+		return fmt.Errorf("apply: converting merged object: %w", err)
+	}
+
+	return c.Update(ctx, live)
+}
+
+// mergeField writes the value owns' current path segment names from
+// desired into merged, unless live's value there has diverged from
+// lastApplied's -- meaning some other actor changed it since we last
+// applied, so we leave their change alone instead of clobbering it.
+func mergeField(merged, live, lastApplied, desired map[string]any, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	seg := path[0]
+
+	if name, isList := strings.CutSuffix(seg, "[*]"); isList {
+		liveList, _ := live[name].([]any)
+		desiredList, _ := desired[name].([]any)
+		lastList, _ := lastApplied[name].([]any)
+
+		// Size to whichever list is longer so an owned list can grow (e.g. a
+		// new container); it never shrinks, since live entries past the end
+		// of the desired list are kept. Build each entry from a
+		// fresh clone of the live item rather than the live item itself --
+		// mergeField mutates mergedItem in place, and live/liveU.Object is
+		// the "before" snapshot Apply diffs against afterward.
+		length := len(liveList)
+		if len(desiredList) > length {
+			length = len(desiredList)
+		}
+
+		mergedList := make([]any, length)
+		for i := 0; i < length; i++ {
+			liveItem, _ := itemAt(liveList, i)
+			desiredItem, _ := itemAt(desiredList, i)
+			lastItem, _ := itemAt(lastList, i)
+
+			mergedItem := map[string]any{}
+			if liveItem != nil {
+				mergedItem = cloneMap(liveItem)
+			}
+			mergeField(mergedItem, liveItem, lastItem, desiredItem, path[1:])
+			mergedList[i] = mergedItem
+		}
+		merged[name] = mergedList
+		return
+	}
+
+	if len(path) == 1 {
+		// Compare as JSON: live's numbers come from the typed object as
+		// int64, lastApplied's from json.Unmarshal as float64.
+		liveJSON, _ := json.Marshal(live[seg])
+		lastJSON, _ := json.Marshal(lastApplied[seg])
+		if string(liveJSON) != string(lastJSON) {
+			merged[seg] = live[seg]
+			return
+		}
+		merged[seg] = desired[seg]
+		return
+	}
+
+	liveChild, _ := live[seg].(map[string]any)
+	desiredChild, _ := desired[seg].(map[string]any)
+	lastChild, _ := lastApplied[seg].(map[string]any)
+	mergedChild, _ := merged[seg].(map[string]any)
+	if mergedChild == nil {
+		mergedChild = map[string]any{}
+	}
+	mergeField(mergedChild, liveChild, lastChild, desiredChild, path[1:])
+	merged[seg] = mergedChild
+}
+
+func itemAt(list []any, i int) (map[string]any, bool) {
+	if i < 0 || i >= len(list) {
+		return nil, false
+	}
+	m, ok := list[i].(map[string]any)
+	return m, ok
+}
+
+// cloneMap deep-copies m so mutating the result can never alias m itself.
+func cloneMap(m map[string]any) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = cloneValue(v)
+	}
+	return out
+}
+
+func cloneValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		return cloneMap(val)
+	case []any:
+		out := make([]any, len(val))
+		for i, e := range val {
+			out[i] = cloneValue(e)
+		}
+		return out
+	default:
+		return val
+	}
+}
+
+// stampLastApplied copies the fields named by owns out of desired and
+// records them as JSON in target's LastAppliedAnnotation.
+func stampLastApplied(target, desired *unstructured.Unstructured, owns Ownership) error {
+	snapshot := map[string]any{}
+	for _, path := range owns {
+		copyField(snapshot, desired.Object, strings.Split(path, "."))
+	}
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("apply: marshaling last-applied snapshot: %w", err)
+	}
+	annotations := target.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedAnnotation] = string(raw)
+	target.SetAnnotations(annotations)
+	return nil
+}
+
+// copyField copies the value at path from src into dst, creating
+// intermediate maps/lists as needed, so snapshots only contain owned
+// fields instead of the whole object.
+func copyField(dst, src map[string]any, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	seg := path[0]
+
+	if name, isList := strings.CutSuffix(seg, "[*]"); isList {
+		srcList, _ := src[name].([]any)
+		dstList, _ := dst[name].([]any)
+		if dstList == nil {
+			dstList = make([]any, len(srcList))
+		}
+		for i := range srcList {
+			srcItem, _ := srcList[i].(map[string]any)
+			dstItem, _ := itemAt(dstList, i)
+			if dstItem == nil {
+				dstItem = map[string]any{}
+			}
+			copyField(dstItem, srcItem, path[1:])
+			dstList[i] = dstItem
+		}
+		dst[name] = dstList
+		return
+	}
+
+	if len(path) == 1 {
+		dst[seg] = src[seg]
+		return
+	}
+
+	srcChild, _ := src[seg].(map[string]any)
+	dstChild, _ := dst[seg].(map[string]any)
+	if dstChild == nil {
+		dstChild = map[string]any{}
+	}
+	copyField(dstChild, srcChild, path[1:])
+	dst[seg] = dstChild
+}
+
+func toUnstructured(scheme *runtime.Scheme, obj client.Object) (*unstructured.Unstructured, error) {
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: raw}
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+	u.SetGroupVersionKind(gvk)
+	return u, nil
+}
+
+func fromUnstructured(scheme *runtime.Scheme, u *unstructured.Unstructured, out client.Object) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, out)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply_test
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/jpfielding/kubeviewer/pkg/apply"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding appsv1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+func deploymentWithImages(images ...string) *appsv1.Deployment {
+	containers := make([]corev1.Container, len(images))
+	for i, image := range images {
+		containers[i] = corev1.Container{Name: "c", Image: image}
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "demo"}},
+				Spec:       corev1.PodSpec{Containers: containers},
+			},
+		},
+	}
+}
+
+// TestApply_SecondApplyRollsOutOwnedFieldChange is a regression test for a
+// bug where mergeField's "[*]" branch built each merged list item from the
+// live object's own map instead of a copy, so mutating it in place also
+// mutated the "before" snapshot Apply diffs against -- making every
+// subsequent Apply of an owned list field a silent no-op.
+func TestApply_SecondApplyRollsOutOwnedFieldChange(t *testing.T) {
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	owns := apply.Ownership{"spec.template.spec.containers[*].image"}
+
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v1"), owns); err != nil {
+		t.Fatalf("initial apply: %v", err)
+	}
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v2"), owns); err != nil {
+		t.Fatalf("second apply: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "demo", Namespace: "default"}, got); err != nil {
+		t.Fatalf("getting stored deployment: %v", err)
+	}
+	if image := got.Spec.Template.Spec.Containers[0].Image; image != "v2" {
+		t.Fatalf("Containers[0].Image = %q, want %q -- owned field change did not roll out", image, "v2")
+	}
+}
+
+// TestApply_OwnedListCanGrow is a regression test for mergedList being sized
+// to len(liveList), which silently dropped any desired list element beyond
+// the live list's current length.
+func TestApply_OwnedListCanGrow(t *testing.T) {
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	owns := apply.Ownership{"spec.template.spec.containers[*].image"}
+
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v1"), owns); err != nil {
+		t.Fatalf("initial apply: %v", err)
+	}
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v1", "sidecar:v1"), owns); err != nil {
+		t.Fatalf("second apply: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "demo", Namespace: "default"}, got); err != nil {
+		t.Fatalf("getting stored deployment: %v", err)
+	}
+	containers := got.Spec.Template.Spec.Containers
+	if len(containers) != 2 {
+		t.Fatalf("len(Containers) = %d, want 2 -- owned list did not grow", len(containers))
+	}
+	if containers[1].Image != "sidecar:v1" {
+		t.Fatalf("Containers[1].Image = %q, want %q", containers[1].Image, "sidecar:v1")
+	}
+}
+
+// TestApply_LeavesUnownedFieldAlone confirms the merge only ever touches
+// fields named in Ownership -- a field another actor set (here, replicas,
+// which this Ownership doesn't list) survives an Apply untouched.
+func TestApply_LeavesUnownedFieldAlone(t *testing.T) {
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	owns := apply.Ownership{"spec.template.spec.containers[*].image"}
+
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v1"), owns); err != nil {
+		t.Fatalf("initial apply: %v", err)
+	}
+
+	live := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "demo", Namespace: "default"}, live); err != nil {
+		t.Fatalf("getting live deployment: %v", err)
+	}
+	hpaReplicas := int32(5)
+	live.Spec.Replicas = &hpaReplicas
+	if err := c.Update(ctx, live); err != nil {
+		t.Fatalf("simulating an HPA writing replicas: %v", err)
+	}
+
+	if err := apply.Apply(ctx, c, scheme, deploymentWithImages("v2"), owns); err != nil {
+		t.Fatalf("second apply: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "demo", Namespace: "default"}, got); err != nil {
+		t.Fatalf("getting stored deployment: %v", err)
+	}
+	if got.Spec.Replicas == nil || *got.Spec.Replicas != 5 {
+		t.Fatalf("Spec.Replicas = %v, want 5 -- Apply touched a field it doesn't own", got.Spec.Replicas)
+	}
+}
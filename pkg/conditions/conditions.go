@@ -0,0 +1,236 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions gives reconcilers a single MarkTrue/MarkFalse call in
+// place of hand-building a metav1.Condition: Manager stamps
+// ObservedGeneration and LastTransitionTime, sets a condition's declared
+// polar opposite to the inverse status, appends to a bounded transition history, updates
+// Prometheus metrics, and fires a Recorder event, all in one place instead
+// of repeated ad-hoc inline in every reconciler.
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	webappv1 "github.com/jpfielding/kubeviewer/api/v1"
+)
+
+// Accessor lets Manager read and write a resource's conditions and
+// condition history without knowing its concrete type. Every CRD managed
+// through this package implements it over its Status struct.
+type Accessor interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions([]metav1.Condition)
+	GetConditionHistory() []webappv1.TransitionRecord
+	SetConditionHistory([]webappv1.TransitionRecord)
+}
+
+// Polarity pairs two declared condition types that are mutual opposites:
+// marking one True sets the other False in the same call, and vice versa.
+type Polarity struct {
+	A, B string
+}
+
+const defaultHistoryLimit = 10
+
+var (
+	transitionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "controller_condition_transitions_total",
+		Help: "Total number of condition status transitions recorded by conditions.Manager.",
+	}, []string{"kind", "type", "reason"})
+
+	conditionCurrent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "controller_condition_current",
+		Help: "1 for the status a condition type currently has on a resource kind, 0 for the others.",
+	}, []string{"kind", "type", "status"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(transitionsTotal, conditionCurrent)
+}
+
+// Config configures a Manager for one CR kind.
+type Config struct {
+	// Kind labels every metric this Manager emits. Events recorded through
+	// Recorder do not carry it.
+	Kind string
+
+	// Types is the declared set of condition types this Manager accepts.
+	// MarkTrue/MarkFalse for any other type panics, since that's always a
+	// programmer error -- an undeclared condition type reaching Reconcile
+	// means a reconciler is setting something its SetupWithManager never
+	// registered.
+	Types []string
+
+	// Polarities declares which of Types clear each other.
+	// +optional
+	Polarities []Polarity
+
+	// HistoryLimit bounds how many entries GetConditionHistory keeps;
+	// defaults to 10.
+	// +optional
+	HistoryLimit int
+
+	// Recorder receives a Normal/Warning event on every transition. May
+	// be nil to skip event recording.
+	Recorder record.EventRecorder
+}
+
+// Manager marks conditions on resources of one CR kind.
+type Manager struct {
+	kind         string
+	types        map[string]struct{}
+	duals        map[string]string
+	negative     map[string]struct{}
+	historyLimit int
+	recorder     record.EventRecorder
+}
+
+// NewManager builds a Manager from cfg.
+func NewManager(cfg Config) *Manager {
+	types := make(map[string]struct{}, len(cfg.Types))
+	for _, t := range cfg.Types {
+		types[t] = struct{}{}
+	}
+	duals := make(map[string]string, len(cfg.Polarities)*2)
+	negative := make(map[string]struct{}, len(cfg.Polarities))
+	for _, p := range cfg.Polarities {
+		duals[p.A] = p.B
+		duals[p.B] = p.A
+		negative[p.B] = struct{}{}
+	}
+	limit := cfg.HistoryLimit
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	return &Manager{
+		kind:         cfg.Kind,
+		types:        types,
+		duals:        duals,
+		negative:     negative,
+		historyLimit: limit,
+		recorder:     cfg.Recorder,
+	}
+}
+
+// MarkTrue sets conditionType to True on resource.
+func (m *Manager) MarkTrue(resource Accessor, conditionType, reason, message string) {
+	m.mark(resource, conditionType, metav1.ConditionTrue, reason, message)
+}
+
+// MarkFalse sets conditionType to False on resource.
+func (m *Manager) MarkFalse(resource Accessor, conditionType, reason, message string) {
+	m.mark(resource, conditionType, metav1.ConditionFalse, reason, message)
+}
+
+func (m *Manager) mark(resource Accessor, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	if _, declared := m.types[conditionType]; !declared {
+		panic(fmt.Sprintf("conditions: %q is not a declared condition type for kind %q", conditionType, m.kind))
+	}
+
+	conditions := resource.GetConditions()
+	prior := meta.FindStatusCondition(conditions, conditionType)
+	transitioned := prior == nil || prior.Status != status
+
+	meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: resource.GetGeneration(),
+	})
+
+	if dual, ok := m.duals[conditionType]; ok {
+		dualStatus := metav1.ConditionTrue
+		if status == metav1.ConditionTrue {
+			dualStatus = metav1.ConditionFalse
+		}
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               dual,
+			Status:             dualStatus,
+			Reason:             reason,
+			Message:            fmt.Sprintf("implied by %s=%s (%s)", conditionType, status, reason),
+			ObservedGeneration: resource.GetGeneration(),
+		})
+	}
+	resource.SetConditions(conditions)
+
+	m.recordMetrics(conditionType, status)
+
+	if !transitioned {
+		return
+	}
+	m.recordTransition(resource, conditionType, status, reason, message)
+}
+
+func (m *Manager) recordMetrics(conditionType string, status metav1.ConditionStatus) {
+	for _, s := range []metav1.ConditionStatus{metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown} {
+		value := 0.0
+		if s == status {
+			value = 1.0
+		}
+		conditionCurrent.WithLabelValues(m.kind, conditionType, string(s)).Set(value)
+	}
+}
+
+func (m *Manager) recordTransition(resource Accessor, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	transitionsTotal.WithLabelValues(m.kind, conditionType, reason).Inc()
+
+	history := append(resource.GetConditionHistory(), webappv1.TransitionRecord{
+		Type:               conditionType,
+		Status:             string(status),
+		Reason:             reason,
+		LastTransitionTime: metav1.Now(),
+	})
+	if len(history) > m.historyLimit {
+		history = history[len(history)-m.historyLimit:]
+	}
+	resource.SetConditionHistory(history)
+
+	if m.recorder == nil {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if m.isBad(conditionType, status) {
+		eventType = corev1.EventTypeWarning
+	}
+	m.recorder.Event(resource, eventType, reason, message)
+}
+
+// isBad reports whether status is the problem state for conditionType, so
+// recordTransition can fire a Warning only when something actually went
+// wrong. A type declared as the B side of a Polarity (e.g. Degraded) is bad
+// when True; its A side (e.g. Ready) is bad when anything but True. A type
+// with no declared Polarity (e.g. Terminating) has no "bad" state of its
+// own -- it always transitions as a Normal event.
+func (m *Manager) isBad(conditionType string, status metav1.ConditionStatus) bool {
+	if _, negative := m.negative[conditionType]; negative {
+		return status == metav1.ConditionTrue
+	}
+	if _, hasDual := m.duals[conditionType]; hasDual {
+		return status != metav1.ConditionTrue
+	}
+	return false
+}
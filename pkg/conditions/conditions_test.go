@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions_test
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/pkg/conditions"
+)
+
+func TestManager_MarkTrueSetsDualFalse(t *testing.T) {
+	m := conditions.NewManager(conditions.Config{
+		Kind:       "MyResource",
+		Types:      []string{"Ready", "Degraded"},
+		Polarities: []conditions.Polarity{{A: "Ready", B: "Degraded"}},
+	})
+
+	resource := &myappv1.MyResource{}
+	m.MarkTrue(resource, "Ready", "Reconciled", "all good")
+
+	if !meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready") {
+		t.Fatalf("Ready condition = %+v, want True", meta.FindStatusCondition(resource.Status.Conditions, "Ready"))
+	}
+	if meta.IsStatusConditionTrue(resource.Status.Conditions, "Degraded") {
+		t.Fatalf("Degraded condition = %+v, want False (cleared by Ready's dual)", meta.FindStatusCondition(resource.Status.Conditions, "Degraded"))
+	}
+}
+
+func TestManager_MarkForUndeclaredTypePanics(t *testing.T) {
+	m := conditions.NewManager(conditions.Config{Kind: "MyResource", Types: []string{"Ready"}})
+	resource := &myappv1.MyResource{}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("MarkTrue for an undeclared condition type did not panic")
+		}
+	}()
+	m.MarkTrue(resource, "NotDeclared", "Reason", "message")
+}
+
+func TestManager_RecordsBoundedHistoryOnlyOnTransition(t *testing.T) {
+	m := conditions.NewManager(conditions.Config{Kind: "MyResource", Types: []string{"Ready"}, HistoryLimit: 2})
+	resource := &myappv1.MyResource{}
+
+	m.MarkTrue(resource, "Ready", "Reconciled", "first")
+	m.MarkTrue(resource, "Ready", "Reconciled", "repeat-no-transition")
+	m.MarkFalse(resource, "Ready", "ChildrenNotReady", "second")
+	m.MarkTrue(resource, "Ready", "Reconciled", "third")
+
+	history := resource.GetConditionHistory()
+	if len(history) != 2 {
+		t.Fatalf("len(history) = %d, want 2 (bounded by HistoryLimit, repeated status isn't a transition)", len(history))
+	}
+	if history[len(history)-1].Status != string(metav1.ConditionTrue) {
+		t.Fatalf("most recent history entry = %+v, want Status True", history[len(history)-1])
+	}
+}
+
+func TestManager_EmitsEventOnTransition(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	m := conditions.NewManager(conditions.Config{Kind: "MyResource", Types: []string{"Ready"}, Recorder: recorder})
+	resource := &myappv1.MyResource{}
+
+	m.MarkTrue(resource, "Ready", "Reconciled", "all good")
+
+	select {
+	case event := <-recorder.Events:
+		if event == "" {
+			t.Fatalf("got empty event")
+		}
+	default:
+		t.Fatalf("MarkTrue transitioned the condition but recorded no event")
+	}
+}
+
+// TestManager_TypeWithNoPolarityNeverWarns is a regression test for a bug
+// where severity was inferred purely from the new status (anything but True
+// was treated as a problem), which fired a Warning event on a clean
+// "Terminating" finish (MarkFalse with reason CleanupComplete) even though
+// nothing went wrong.
+func TestManager_TypeWithNoPolarityNeverWarns(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	m := conditions.NewManager(conditions.Config{Kind: "MyResource", Types: []string{"Terminating"}, Recorder: recorder})
+	resource := &myappv1.MyResource{}
+
+	m.MarkTrue(resource, "Terminating", "CleanupInProgress", "waiting on cleanup step")
+	m.MarkFalse(resource, "Terminating", "CleanupComplete", "all cleanup steps complete")
+
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-recorder.Events:
+			if !strings.Contains(event, corev1.EventTypeNormal) {
+				t.Fatalf("event %d = %q, want a Normal event (Terminating has no declared polarity, so neither status is a problem)", i, event)
+			}
+		default:
+			t.Fatalf("expected %d events from Terminating transitions, got fewer", i+1)
+		}
+	}
+}
+
+// TestManager_NegativePolaritySideWarnsOnTrue confirms a B-side Polarity type
+// (e.g. Degraded) is treated as bad when True, the opposite of its A side.
+func TestManager_NegativePolaritySideWarnsOnTrue(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	m := conditions.NewManager(conditions.Config{
+		Kind:       "MyResource",
+		Types:      []string{"Ready", "Degraded"},
+		Polarities: []conditions.Polarity{{A: "Ready", B: "Degraded"}},
+		Recorder:   recorder,
+	})
+	resource := &myappv1.MyResource{}
+
+	m.MarkTrue(resource, "Degraded", "ChildError", "a child resource is failing")
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, corev1.EventTypeWarning) {
+			t.Fatalf("event = %q, want a Warning event (Degraded=True is the problem state)", event)
+		}
+	default:
+		t.Fatalf("MarkTrue transitioned the condition but recorded no event")
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subreconciler lets a controller's Reconcile be assembled from an
+// ordered list of small, independently testable steps instead of one
+// monolithic function. A Pipeline drives the steps in order, stopping early
+// when a step asks to Halt, and performs a single consolidated
+// Status().Update once the steps have run (including after a Halt).
+package subreconciler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Result is what a SubReconciler step returns. The Pipeline driver folds the
+// Results of every step that ran into the single Result it returns from
+// Reconcile.
+type Result struct {
+	// RequeueAfter asks the driver to requeue the parent after this long.
+	// If more than one step in a run sets this, the shortest wins.
+	RequeueAfter time.Duration
+
+	// Halt tells the driver to stop running the remaining steps this
+	// invocation, e.g. because this step already persisted a change
+	// (adding a finalizer) that would make downstream steps act on stale
+	// data if they ran against the in-memory object now.
+	Halt bool
+}
+
+// SubReconciler is one composable step in a reconciler Pipeline. T is the
+// custom resource type the pipeline drives, e.g. *myappv1.MyResource.
+type SubReconciler[T client.Object] interface {
+	// Name identifies the step in logs.
+	Name() string
+
+	// Reconcile runs this step against parent. Steps that need to persist
+	// something immediately (e.g. adding a finalizer) should call the
+	// client directly and return Halt: true; everything else should just
+	// mutate parent in place and let the Pipeline's consolidated
+	// Status().Update pick it up.
+	Reconcile(ctx context.Context, parent T) (Result, error)
+}
+
+// Pipeline drives an ordered list of SubReconciler steps against a parent
+// custom resource.
+type Pipeline[T client.Object] struct {
+	Client client.Client
+	Steps  []SubReconciler[T]
+}
+
+// Reconcile runs every step in order against parent, stopping early on
+// error or Halt, then writes parent's status once if any step changed it.
+func (p *Pipeline[T]) Reconcile(ctx context.Context, parent T) (Result, error) {
+	log := logf.FromContext(ctx)
+
+	original, ok := parent.DeepCopyObject().(T)
+	if !ok {
+		// Every client.Object's DeepCopyObject returns itself; this only
+		// fails if T is an interface wider than the concrete type, which
+		// callers shouldn't do.
+		original = parent
+	}
+
+	var result Result
+	for _, step := range p.Steps {
+		stepResult, err := step.Reconcile(ctx, parent)
+		if err != nil {
+			return Result{}, err
+		}
+		if stepResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || stepResult.RequeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = stepResult.RequeueAfter
+		}
+		if stepResult.Halt {
+			log.V(1).Info("sub-reconciler halted the pipeline", "step", step.Name())
+			result.Halt = true
+			break
+		}
+	}
+
+	// Resources whose status embeds an ObservedGeneration field implement
+	// this optionally, rather than Pipeline needing to know T's status shape.
+	if setter, ok := any(parent).(interface{ SetObservedGeneration(int64) }); ok {
+		setter.SetObservedGeneration(parent.GetGeneration())
+	}
+
+	// Status().Update only ever writes the status subresource, so comparing
+	// (and writing) the whole object here is safe even though steps that
+	// Halted may also have changed non-status fields directly.
+	if !equality.Semantic.DeepEqual(original, parent) {
+		if err := p.Client.Status().Update(ctx, parent); err != nil {
+			return Result{}, err
+		}
+	}
+
+	return result, nil
+}
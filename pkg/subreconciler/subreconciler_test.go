@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subreconciler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	myappv1 "github.com/jpfielding/kubeviewer/api/v1"
+	"github.com/jpfielding/kubeviewer/pkg/subreconciler"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := myappv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding api/v1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+// haltStep always halts the pipeline without touching status, like
+// EnsureFinalizer does once it has just written the finalizer itself.
+type haltStep struct{}
+
+func (haltStep) Name() string { return "halt" }
+
+func (haltStep) Reconcile(context.Context, *myappv1.MyResource) (subreconciler.Result, error) {
+	return subreconciler.Result{Halt: true}, nil
+}
+
+func TestHarness_RunsASingleStep(t *testing.T) {
+	scheme := newScheme(t)
+	harness := subreconciler.NewHarness[*myappv1.MyResource](scheme)
+
+	result, err := harness.Run(context.Background(), haltStep{}, &myappv1.MyResource{})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if !result.Halt {
+		t.Fatalf("Run result = %+v, want Halt: true", result)
+	}
+}
+
+// requeueStep asks to be requeued and records a not-ready Deployment in
+// status.children, so the Pipeline's fold and consolidated status write can
+// both be observed.
+type requeueStep struct {
+	after time.Duration
+}
+
+func (requeueStep) Name() string { return "requeue" }
+
+func (s requeueStep) Reconcile(_ context.Context, resource *myappv1.MyResource) (subreconciler.Result, error) {
+	resource.Status.Children.Deployments = append(resource.Status.Children.Deployments, myappv1.ObjectReadiness{
+		Name:   resource.Name,
+		Ready:  false,
+		Reason: "RolloutInProgress",
+	})
+	return subreconciler.Result{RequeueAfter: s.after}, nil
+}
+
+func TestPipeline_FoldsShortestRequeueAndStampsObservedGeneration(t *testing.T) {
+	scheme := newScheme(t)
+	resource := &myappv1.MyResource{}
+	resource.Name = "demo"
+	resource.Namespace = "default"
+	resource.Generation = 3
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resource).WithStatusSubresource(resource).Build()
+
+	pipeline := &subreconciler.Pipeline[*myappv1.MyResource]{
+		Client: c,
+		Steps: []subreconciler.SubReconciler[*myappv1.MyResource]{
+			requeueStep{after: 30 * time.Second},
+			requeueStep{after: 5 * time.Second},
+		},
+	}
+
+	result, err := pipeline.Reconcile(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	if result.RequeueAfter != 5*time.Second {
+		t.Fatalf("RequeueAfter = %v, want %v (the shorter of the two steps)", result.RequeueAfter, 5*time.Second)
+	}
+
+	got := &myappv1.MyResource{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(resource), got); err != nil {
+		t.Fatalf("getting stored resource: %v", err)
+	}
+	if got.Status.ObservedGeneration != 3 {
+		t.Fatalf("Status.ObservedGeneration = %d, want 3", got.Status.ObservedGeneration)
+	}
+	if len(got.Status.Children.Deployments) != 2 {
+		t.Fatalf("Status.Children.Deployments = %v, want 2 entries (one per step)", got.Status.Children.Deployments)
+	}
+}
+
+func TestPipeline_HaltStopsRemainingSteps(t *testing.T) {
+	scheme := newScheme(t)
+	resource := &myappv1.MyResource{}
+	resource.Name = "demo"
+	resource.Namespace = "default"
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resource).WithStatusSubresource(resource).Build()
+
+	ran := 0
+	countingStep := countingStep{ran: &ran}
+
+	pipeline := &subreconciler.Pipeline[*myappv1.MyResource]{
+		Client: c,
+		Steps: []subreconciler.SubReconciler[*myappv1.MyResource]{
+			haltStep{},
+			countingStep,
+		},
+	}
+
+	if _, err := pipeline.Reconcile(context.Background(), resource); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	if ran != 0 {
+		t.Fatalf("step after Halt ran %d times, want 0", ran)
+	}
+}
+
+type countingStep struct {
+	ran *int
+}
+
+func (countingStep) Name() string { return "counting" }
+
+func (s countingStep) Reconcile(context.Context, *myappv1.MyResource) (subreconciler.Result, error) {
+	*s.ran++
+	return subreconciler.Result{}, nil
+}
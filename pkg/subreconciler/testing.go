@@ -0,0 +1,48 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subreconciler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Harness drives a single SubReconciler step against a fake client, so a
+// step can be unit tested without standing up the rest of the pipeline.
+type Harness[T client.Object] struct {
+	Client client.Client
+}
+
+// NewHarness builds a Harness backed by a fake client seeded with objs (the
+// parent resource plus whatever children the step under test expects to
+// find).
+func NewHarness[T client.Object](scheme *runtime.Scheme, objs ...client.Object) *Harness[T] {
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	if len(objs) > 0 {
+		builder = builder.WithObjects(objs...).WithStatusSubresource(objs...)
+	}
+	return &Harness[T]{Client: builder.Build()}
+}
+
+// Run drives step once against parent, returning its Result the same way
+// Pipeline.Reconcile would see it.
+func (h *Harness[T]) Run(ctx context.Context, step SubReconciler[T], parent T) (Result, error) {
+	return step.Reconcile(ctx, parent)
+}